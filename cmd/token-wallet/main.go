@@ -0,0 +1,112 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Command token-wallet serves a remote.Wallet backed by on-disk keys (a
+// remote.FileKeyStore under -keystore) as a wallet daemon, so that issuer,
+// owner, auditor, and certifier key material never has to live in the same
+// process as the token service. It has no option to serve an HSM-backed
+// KeyStore. Deployments point a RemoteSigner at this daemon's listen address
+// instead of loading keys locally; RemoteSigner dials plain TCP, so it can
+// reach the daemon only when it runs without -tls-cert and -tls-key.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/identity/remote"
+)
+
+var errNeedBothCertAndKey = errors.New("-tls-cert and -tls-key must be set together")
+
+func main() {
+	listenAddr := flag.String("listen", "127.0.0.1:9443", "address the wallet daemon listens on")
+	keystoreDir := flag.String("keystore", "", "directory holding the on-disk key material")
+	tokenFile := flag.String("token-file", "", "file holding the shared secret RPC callers must present (required)")
+	tlsCertFile := flag.String("tls-cert", "", "TLS certificate file; enables TLS when set together with -tls-key")
+	tlsKeyFile := flag.String("tls-key", "", "TLS private key file; enables TLS when set together with -tls-cert")
+	tlsClientCAFile := flag.String("tls-client-ca", "", "PEM file of client CAs to require and verify (enables mutual TLS)")
+	flag.Parse()
+
+	if *keystoreDir == "" {
+		log.Fatal("token-wallet: -keystore is required")
+	}
+	if *tokenFile == "" {
+		log.Fatal("token-wallet: -token-file is required")
+	}
+
+	rawToken, err := os.ReadFile(*tokenFile)
+	if err != nil {
+		log.Fatalf("token-wallet: failed to read token file [%s]: %v", *tokenFile, err)
+	}
+	token := strings.TrimSpace(string(rawToken))
+	if token == "" {
+		log.Fatalf("token-wallet: token file [%s] is empty", *tokenFile)
+	}
+
+	tlsConfig, err := loadTLSConfig(*tlsCertFile, *tlsKeyFile, *tlsClientCAFile)
+	if err != nil {
+		log.Fatalf("token-wallet: %v", err)
+	}
+
+	ks, err := remote.NewFileKeyStore(*keystoreDir)
+	if err != nil {
+		log.Fatalf("token-wallet: failed to open keystore [%s]: %v", *keystoreDir, err)
+	}
+
+	wallet := remote.NewWallet(ks, token)
+	log.Printf("token-wallet: serving wallet [%s] on [%s]", *keystoreDir, *listenAddr)
+	if err := remote.Serve(wallet, *listenAddr, tlsConfig); err != nil {
+		log.Fatalf("token-wallet: %v", err)
+	}
+}
+
+// loadTLSConfig returns nil when no TLS flags were supplied, so Serve falls back to plain TCP
+// with Wallet.Token as its only line of defense; otherwise it loads the server certificate and,
+// when tlsClientCAFile is set, requires and verifies a client certificate against it.
+func loadTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, errors.New("-tls-client-ca requires -tls-cert and -tls-key")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errNeedBothCertAndKey
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if clientCAFile != "" {
+		pool, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// loadCertPool reads a PEM file of one or more CA certificates into a CertPool.
+func loadCertPool(pemFile string) (*x509.CertPool, error) {
+	raw, err := os.ReadFile(pemFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(raw) {
+		return nil, errors.New("no certificates found in " + pemFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fabric
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	topology2 "github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/topology"
+
+	. "github.com/onsi/gomega"
+)
+
+// CollectionConfig describes a single Fabric private-data collection to be
+// declared for a TMS's chaincode, for token-adjacent state that should not
+// be replicated to every peer on the channel (audit trails, KYC bindings,
+// unlinkable owner metadata, ...).
+type CollectionConfig struct {
+	// Name identifies the collection; chaincode reads/writes it by this name.
+	Name string
+	// Policy is the Fabric signature policy controlling which orgs may
+	// persist to the collection (e.g. "OR('Org1MSP.member','Org2MSP.member')").
+	Policy string
+	// RequiredPeerCount is the minimum number of peers the endorsing peer
+	// must successfully disseminate private data to before endorsing.
+	RequiredPeerCount int
+	// MaxPeerCount is the number of peers private data is disseminated to,
+	// beyond RequiredPeerCount, for redundancy.
+	MaxPeerCount int
+	// BlockToLive is the number of blocks after which the collection's
+	// private data is purged; 0 means never.
+	BlockToLive uint64
+	// MemberOnlyRead restricts reads of the collection to its members.
+	MemberOnlyRead bool
+	// MemberOnlyWrite restricts writes to the collection to its members.
+	MemberOnlyWrite bool
+	// EndorsementPolicy overrides the chaincode-level endorsement policy for
+	// this collection; empty means inherit the chaincode's policy.
+	EndorsementPolicy string
+}
+
+// collectionJSON is the on-disk shape Fabric's peer CLI expects in a
+// collections_config.json file.
+type collectionJSON struct {
+	Name              string                `json:"name"`
+	Policy            string                `json:"policy"`
+	RequiredPeerCount int                   `json:"requiredPeerCount"`
+	MaxPeerCount      int                   `json:"maxPeerCount"`
+	BlockToLive       uint64                `json:"blockToLive"`
+	MemberOnlyRead    bool                  `json:"memberOnlyRead"`
+	MemberOnlyWrite   bool                  `json:"memberOnlyWrite"`
+	EndorsementPolicy *endorsementPolicyRef `json:"endorsementPolicy,omitempty"`
+}
+
+type endorsementPolicyRef struct {
+	SignaturePolicy string `json:"signaturePolicy"`
+}
+
+// AddCollection appends cfg to tms's private-data collections, to be
+// rendered into a collections_config.json at chaincode-deploy time by
+// GenerateArtifacts.
+func (p *NetworkHandler) AddCollection(tms *topology2.TMS, cfg CollectionConfig) {
+	tms.Collections = append(tms.Collections, cfg)
+}
+
+// CollectionsConfigFile returns the path GenerateArtifacts writes tms's
+// collections_config.json to.
+func (p *NetworkHandler) CollectionsConfigFile(tms *topology2.TMS) string {
+	return filepath.Join(
+		p.TokenPlatform.PublicParametersDir(),
+		fmt.Sprintf("%s_%s_%s_collections.json", tms.Network, tms.Channel, tms.Namespace),
+	)
+}
+
+// generateCollectionsConfig renders tms's collections into a
+// collections_config.json file and returns its path, or "" if tms declares
+// no collections.
+func (p *NetworkHandler) generateCollectionsConfig(tms *topology2.TMS) string {
+	if len(tms.Collections) == 0 {
+		return ""
+	}
+
+	configs := make([]collectionJSON, 0, len(tms.Collections))
+	for _, c := range tms.Collections {
+		entry := collectionJSON{
+			Name:              c.Name,
+			Policy:            c.Policy,
+			RequiredPeerCount: c.RequiredPeerCount,
+			MaxPeerCount:      c.MaxPeerCount,
+			BlockToLive:       c.BlockToLive,
+			MemberOnlyRead:    c.MemberOnlyRead,
+			MemberOnlyWrite:   c.MemberOnlyWrite,
+		}
+		if c.EndorsementPolicy != "" {
+			entry.EndorsementPolicy = &endorsementPolicyRef{SignaturePolicy: c.EndorsementPolicy}
+		}
+		configs = append(configs, entry)
+	}
+
+	raw, err := json.MarshalIndent(configs, "", "  ")
+	Expect(err).NotTo(HaveOccurred())
+
+	path := p.CollectionsConfigFile(tms)
+	Expect(os.MkdirAll(filepath.Dir(path), 0766)).NotTo(HaveOccurred())
+	Expect(ioutil.WriteFile(path, raw, 0766)).NotTo(HaveOccurred())
+	return path
+}
@@ -0,0 +1,122 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fabric
+
+import (
+	"fmt"
+
+	topology2 "github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/topology"
+)
+
+// Peer identifies a Fabric peer returned by discovery or resolved from the
+// network topology.
+type Peer struct {
+	MSPID    string
+	PKIID    string
+	Endpoint string
+}
+
+// discoveryPlatform is an optional capability a fabricPlatform can implement
+// to back SelectEndorsers with live Fabric discovery instead of the static
+// topology. Few networks in this framework expose it; SelectEndorsers falls
+// back to topology-derived peers when it is absent.
+type discoveryPlatform interface {
+	PeersOfChannel(channel string) ([]Peer, error)
+	Endorsers(namespace string, collection string, policy string) ([]Peer, error)
+}
+
+// SelectEndorsers returns the peer set that should endorse an invocation of
+// chaincodeID on the given collection (pass "" for the chaincode's own
+// state). When the network exposes live discovery (discoveryPlatform), it is
+// used to compute the policy-minimal set; otherwise one peer per
+// organization in tms.TokenChaincode.Orgs is returned, resolved through the
+// topology via PeerInOrg, with its address looked up via PeerChaincodeAddress.
+func (p *NetworkHandler) SelectEndorsers(tms *topology2.TMS, chaincodeID string, collection string) ([]Peer, error) {
+	if d, ok := p.TokenPlatform.GetContext().PlatformByName(tms.Network).(discoveryPlatform); ok {
+		resolved, err := p.selectEndorsersByDiscovery(tms, d, chaincodeID, collection)
+		if err != nil {
+			return nil, err
+		}
+		return resolved, nil
+	}
+
+	logger.Debugf(
+		"network [%s] does not expose live discovery, falling back to topology-derived endorsers for [%s:%s]",
+		tms.Network, chaincodeID, collection,
+	)
+	return p.selectEndorsersByTopology(tms, p.Fabric(tms))
+}
+
+// selectEndorsersByDiscovery queries live discovery for the minimal peer set
+// satisfying chaincodeID's endorsement policy, resolving any peer discovery
+// left without an endpoint by MSPID + PKI-ID among the peers discovery
+// reports for the channel (PeersOfChannel), since discovery may legitimately
+// omit the local peer's own endpoint. The static topology is not consulted;
+// a peer missing from PeersOfChannel as well makes the call fail.
+func (p *NetworkHandler) selectEndorsersByDiscovery(tms *topology2.TMS, d discoveryPlatform, chaincodeID string, collection string) ([]Peer, error) {
+	channelPeers, err := d.PeersOfChannel(tms.Channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover peers of channel [%s]: %w", tms.Channel, err)
+	}
+
+	endorsers, err := d.Endorsers(chaincodeID, collection, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover endorsers for [%s:%s]: %w", chaincodeID, collection, err)
+	}
+
+	resolved := make([]Peer, 0, len(endorsers))
+	for _, e := range endorsers {
+		if e.Endpoint == "" {
+			local, err := p.resolvePeerByIdentity(tms, channelPeers, e.MSPID, e.PKIID)
+			if err != nil {
+				return nil, err
+			}
+			e = local
+		}
+		resolved = append(resolved, e)
+	}
+	return resolved, nil
+}
+
+// selectEndorsersByTopology returns one Peer per organization hosting
+// tms.TokenChaincode. For each org it first resolves the name of a peer that belongs to it via
+// PeerInOrg, since PeerChaincodeAddress takes a peer name, not an MSPID, and only then looks up
+// its chaincode address. It relies on no fabricPlatform capability beyond PeerInOrg and
+// PeerChaincodeAddress. fp is taken as a parameter, rather than resolved via p.Fabric(tms), so it
+// can be exercised against a fake in unit tests.
+func (p *NetworkHandler) selectEndorsersByTopology(tms *topology2.TMS, fp fabricPlatform) ([]Peer, error) {
+	if len(tms.TokenChaincode.Orgs) == 0 {
+		return nil, fmt.Errorf("no orgs configured for chaincode namespace [%s]", tms.Namespace)
+	}
+	resolved := make([]Peer, 0, len(tms.TokenChaincode.Orgs))
+	for _, org := range tms.TokenChaincode.Orgs {
+		peerName, err := fp.PeerInOrg(org)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve a peer for org [%s]: %w", org, err)
+		}
+		resolved = append(resolved, Peer{
+			MSPID:    org,
+			Endpoint: fp.PeerChaincodeAddress(peerName),
+		})
+	}
+	return resolved, nil
+}
+
+// resolvePeerByIdentity looks up, among channelPeers, the one matching mspID
+// and pkiID, used as a fallback when discovery does not report an endpoint
+// for the local peer.
+func (p *NetworkHandler) resolvePeerByIdentity(tms *topology2.TMS, channelPeers []Peer, mspID string, pkiID string) (Peer, error) {
+	for _, peer := range channelPeers {
+		if peer.MSPID == mspID && peer.PKIID == pkiID {
+			return peer, nil
+		}
+	}
+	return Peer{}, fmt.Errorf(
+		"failed to resolve endpoint for peer [MSPID=%s, PKI-ID=%s] on channel [%s]",
+		mspID, pkiID, tms.Channel,
+	)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-smart-client/integration/nwo/fabric/topology"
+	topology2 "github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeFabricPlatform is a minimal fabricPlatform for exercising
+// selectEndorsersByTopology without a live Fabric network.
+type fakeFabricPlatform struct {
+	peersByOrg map[string]string
+	addresses  map[string]string
+}
+
+func (f *fakeFabricPlatform) DeployChaincode(*topology.ChannelChaincode) {}
+func (f *fakeFabricPlatform) InvokeChaincode(*topology.ChannelChaincode, string, ...[]byte) []byte {
+	return nil
+}
+func (f *fakeFabricPlatform) DefaultIdemixOrgMSPDir() string { return "" }
+func (f *fakeFabricPlatform) Topology() *topology.Topology   { return nil }
+func (f *fakeFabricPlatform) PeerChaincodeAddress(peerName string) string {
+	return f.addresses[peerName]
+}
+func (f *fakeFabricPlatform) PeerInOrg(mspID string) (string, error) {
+	peerName, ok := f.peersByOrg[mspID]
+	if !ok {
+		return "", fmt.Errorf("no peer known for org [%s]", mspID)
+	}
+	return peerName, nil
+}
+
+func TestSelectEndorsersByTopology(t *testing.T) {
+	p := &NetworkHandler{}
+	tms := &topology2.TMS{Namespace: "ns"}
+	tms.TokenChaincode.Orgs = []string{"Org1MSP", "Org2MSP"}
+
+	fp := &fakeFabricPlatform{
+		peersByOrg: map[string]string{"Org1MSP": "peer0.org1", "Org2MSP": "peer0.org2"},
+		addresses:  map[string]string{"peer0.org1": "org1:7051", "peer0.org2": "org2:7051"},
+	}
+
+	resolved, err := p.selectEndorsersByTopology(tms, fp)
+	assert.NoError(t, err)
+	assert.Equal(t, []Peer{
+		{MSPID: "Org1MSP", Endpoint: "org1:7051"},
+		{MSPID: "Org2MSP", Endpoint: "org2:7051"},
+	}, resolved)
+}
+
+func TestSelectEndorsersByTopologyNoOrgs(t *testing.T) {
+	p := &NetworkHandler{}
+	tms := &topology2.TMS{Namespace: "ns"}
+
+	_, err := p.selectEndorsersByTopology(tms, &fakeFabricPlatform{})
+	assert.Error(t, err)
+}
+
+func TestSelectEndorsersByTopologyUnresolvablePeer(t *testing.T) {
+	p := &NetworkHandler{}
+	tms := &topology2.TMS{Namespace: "ns"}
+	tms.TokenChaincode.Orgs = []string{"Org1MSP"}
+
+	_, err := p.selectEndorsersByTopology(tms, &fakeFabricPlatform{})
+	assert.Error(t, err)
+}
+
+// fakeDiscoveryPlatform is a minimal discoveryPlatform for exercising
+// selectEndorsersByDiscovery without live Fabric discovery.
+type fakeDiscoveryPlatform struct {
+	channelPeers []Peer
+	endorsers    []Peer
+	endorsersErr error
+}
+
+func (f *fakeDiscoveryPlatform) PeersOfChannel(channel string) ([]Peer, error) {
+	return f.channelPeers, nil
+}
+
+func (f *fakeDiscoveryPlatform) Endorsers(namespace string, collection string, policy string) ([]Peer, error) {
+	return f.endorsers, f.endorsersErr
+}
+
+func TestSelectEndorsersByDiscovery(t *testing.T) {
+	p := &NetworkHandler{}
+	tms := &topology2.TMS{Channel: "ch1"}
+
+	d := &fakeDiscoveryPlatform{
+		channelPeers: []Peer{{MSPID: "Org1MSP", PKIID: "pki1", Endpoint: "org1:7051"}},
+		endorsers:    []Peer{{MSPID: "Org1MSP", PKIID: "pki1"}, {MSPID: "Org2MSP", Endpoint: "org2:7051"}},
+	}
+
+	resolved, err := p.selectEndorsersByDiscovery(tms, d, "ns", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []Peer{
+		{MSPID: "Org1MSP", PKIID: "pki1", Endpoint: "org1:7051"},
+		{MSPID: "Org2MSP", Endpoint: "org2:7051"},
+	}, resolved)
+}
+
+func TestSelectEndorsersByDiscoveryUnresolvableEndpoint(t *testing.T) {
+	p := &NetworkHandler{}
+	tms := &topology2.TMS{Channel: "ch1"}
+
+	d := &fakeDiscoveryPlatform{
+		endorsers: []Peer{{MSPID: "Org1MSP", PKIID: "pki1"}},
+	}
+
+	_, err := p.selectEndorsersByDiscovery(tms, d, "ns", "")
+	assert.Error(t, err)
+}
@@ -22,11 +22,14 @@ import (
 	"github.com/hyperledger-labs/fabric-smart-client/integration/nwo/fsc"
 	sfcnode "github.com/hyperledger-labs/fabric-smart-client/integration/nwo/fsc/node"
 	"github.com/hyperledger-labs/fabric-smart-client/platform/view/services/flogging"
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gexec"
 
 	"github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/generators"
 	topology2 "github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/topology"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/wallet"
 )
 
 var logger = flogging.MustGetLogger("integration.token.fabric")
@@ -41,6 +44,9 @@ type fabricPlatform interface {
 	DefaultIdemixOrgMSPDir() string
 	Topology() *topology.Topology
 	PeerChaincodeAddress(peerName string) string
+	// PeerInOrg returns the name of a peer belonging to the organization identified by mspID, for
+	// resolving an endpoint via PeerChaincodeAddress, which takes a peer name rather than an MSPID.
+	PeerInOrg(mspID string) (string, error)
 }
 
 type tokenPlatform interface {
@@ -55,9 +61,10 @@ type tokenPlatform interface {
 }
 
 type Entry struct {
-	TMS     *topology2.TMS
-	TCC     *TCC
-	Wallets map[string]*Wallet
+	TMS                   *topology2.TMS
+	TCC                   *TCC
+	Wallets               map[string]*Wallet
+	CollectionsConfigPath string
 }
 
 type NetworkHandler struct {
@@ -66,6 +73,16 @@ type NetworkHandler struct {
 	TokenChaincodePath string
 	colorIndex         int
 	Entries            map[string]*Entry
+
+	// IdentityWallet, when set, receives a copy of every identity GenerateCryptoMaterial
+	// generates, decoupling identity storage from this eagerly-generated, fixed on-disk layout so
+	// deployments can plug in a filesystem- or HSM-backed wallet.Wallet instead.
+	IdentityWallet wallet.Wallet
+
+	// RevocationRegistry, when set, is where RotateIdentity records the identity a rotation
+	// replaces, so that a driver.RevocationAwareIdentityProvider consulting the same
+	// registry honors rotations driven through this NetworkHandler.
+	RevocationRegistry driver.RevocationRegistry
 }
 
 func NewNetworkHandler(tokenPlatform tokenPlatform) *NetworkHandler {
@@ -125,6 +142,13 @@ func (p *NetworkHandler) GenerateArtifacts(tms *topology2.TMS) {
 		p.Fabric(tms).Topology().AddChaincode(chaincode)
 	}
 	entry.TCC = &TCC{Chaincode: chaincode}
+
+	// Render any declared private-data collections and attach them to the chaincode definition,
+	// so DeployChaincode actually installs them instead of just leaving the file on disk.
+	entry.CollectionsConfigPath = p.generateCollectionsConfig(tms)
+	if entry.CollectionsConfigPath != "" {
+		chaincode.Chaincode.CollectionsConfig = entry.CollectionsConfigPath
+	}
 }
 
 func (p *NetworkHandler) GenerateExtension(tms *topology2.TMS, node *sfcnode.Node) string {
@@ -150,13 +174,13 @@ func (p *NetworkHandler) GenerateCryptoMaterial(cmGenerator generators.CryptoMat
 	o := node.PlatformOpts()
 	opts := topology2.ToOptions(o)
 
-	wallet := &Wallet{
+	nodeWallet := &Wallet{
 		Certifiers: []Identity{},
 		Issuers:    []Identity{},
 		Owners:     []Identity{},
 		Auditors:   []Identity{},
 	}
-	entry.Wallets[node.Name] = wallet
+	entry.Wallets[node.Name] = nodeWallet
 
 	// Issuer identities
 	issuers := opts.Issuers()
@@ -164,9 +188,10 @@ func (p *NetworkHandler) GenerateCryptoMaterial(cmGenerator generators.CryptoMat
 		issuers = append(issuers, node.ID())
 		ids := cmGenerator.GenerateIssuerIdentities(tms, node, issuers...)
 		for _, id := range ids {
-			wallet.Issuers = append(wallet.Issuers, Identity(id))
+			nodeWallet.Issuers = append(nodeWallet.Issuers, Identity(id))
 		}
-		wallet.Issuers[len(wallet.Issuers)-1].Default = true
+		nodeWallet.Issuers[len(nodeWallet.Issuers)-1].Default = true
+		p.persistIdentities(tms, node, "issuer", nodeWallet.Issuers)
 	}
 
 	// Owner identities
@@ -176,23 +201,46 @@ func (p *NetworkHandler) GenerateCryptoMaterial(cmGenerator generators.CryptoMat
 		owners = append(owners, node.ID())
 		ids := cmGenerator.GenerateOwnerIdentities(tms, node, owners...)
 		for _, id := range ids {
-			wallet.Owners = append(wallet.Owners, Identity(id))
+			nodeWallet.Owners = append(nodeWallet.Owners, Identity(id))
 		}
-		wallet.Owners[len(wallet.Owners)-1].Default = true
+		nodeWallet.Owners[len(nodeWallet.Owners)-1].Default = true
+		p.persistIdentities(tms, node, "owner", nodeWallet.Owners)
 	}
 
 	// Auditor identity
 	if opts.Auditor() {
 		ids := cmGenerator.GenerateAuditorIdentities(tms, node, node.Name)
-		wallet.Auditors = append(wallet.Auditors, Identity(ids[0]))
-		wallet.Auditors[0].Default = true
+		nodeWallet.Auditors = append(nodeWallet.Auditors, Identity(ids[0]))
+		nodeWallet.Auditors[0].Default = true
+		p.persistIdentities(tms, node, "auditor", nodeWallet.Auditors)
 	}
 
 	// Certifier identities
 	if opts.Certifier() {
 		ids := cmGenerator.GenerateCertifierIdentities(tms, node, node.Name)
-		wallet.Certifiers = append(wallet.Certifiers, Identity(ids[0]))
-		wallet.Certifiers[0].Default = true
+		nodeWallet.Certifiers = append(nodeWallet.Certifiers, Identity(ids[0]))
+		nodeWallet.Certifiers[0].Default = true
+		p.persistIdentities(tms, node, "certifier", nodeWallet.Certifiers)
+	}
+}
+
+// persistIdentities mirrors role's identities for node into p.IdentityWallet, when configured, so
+// that a wallet.Wallet backend (filesystem, PKCS#11, ...) can serve them instead of callers having
+// to read this eagerly-generated, fixed on-disk layout directly.
+func (p *NetworkHandler) persistIdentities(tms *topology2.TMS, node *sfcnode.Node, role string, identities []Identity) {
+	if p.IdentityWallet == nil {
+		return
+	}
+	for i, identity := range identities {
+		label := fmt.Sprintf("%s.%s.%s.%d", tms.Namespace, node.Name, role, i)
+		entry := wallet.Identity{
+			ID: identity.ID,
+			Data: wallet.IdentityData{
+				Type:  tms.Driver,
+				MSPID: node.Name,
+			},
+		}
+		Expect(p.IdentityWallet.Put(label, entry)).NotTo(HaveOccurred())
 	}
 }
 
@@ -212,6 +260,17 @@ func (p *NetworkHandler) FSCCertifierCryptoMaterialDir(tms *topology2.TMS, peer
 	)
 }
 
+// RotateIdentity revokes oldID as driver.Superseded in p.RevocationRegistry, when configured, and
+// re-renders node's FSC extension config from the node's current wallet entries. newID is not
+// written into those entries or into the rendered config; it is expected to already be bound
+// against the node's IdentityProvider via Bind.
+func (p *NetworkHandler) RotateIdentity(tms *topology2.TMS, node *sfcnode.Node, oldID view.Identity, newID view.Identity) string {
+	if p.RevocationRegistry != nil {
+		Expect(p.RevocationRegistry.Add(oldID, driver.Superseded)).NotTo(HaveOccurred())
+	}
+	return p.GenerateExtension(tms, node)
+}
+
 func (p *NetworkHandler) GetEntry(tms *topology2.TMS) *Entry {
 	entry, ok := p.Entries[tms.Network+tms.Channel+tms.Namespace]
 	if !ok {
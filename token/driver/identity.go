@@ -46,4 +46,51 @@ type IdentityProvider interface {
 	// Bind binds id to the passed identity long term identity. The same signer, verifier, and audit of the long term
 	// identity is associated to id.
 	Bind(id view.Identity, longTerm view.Identity) error
+
+	// Revoke marks identity as revoked for reason, so that it can no longer be used to issue, own,
+	// audit, or certify tokens. An auditor uses this to invalidate a compromised identity without
+	// regenerating the whole TMS.
+	Revoke(identity view.Identity, reason RevocationReason) error
+
+	// IsRevoked returns whether identity has been revoked.
+	IsRevoked(identity view.Identity) (bool, error)
+
+	// Rotate replaces oldID with newID, preserving oldID's role and metadata while revoking oldID
+	// as Superseded. newID must already be bound via Bind before it can be rotated in.
+	Rotate(oldID view.Identity, newID view.Identity) error
+
+	// CheckEndorsingOrg reports whether org is allowed to endorse on behalf of identity. Wallets
+	// use this to constrain which organizations are asked to endorse issuer-only or auditor-only
+	// sensitive flows, independent of the chaincode-level endorsement policy.
+	CheckEndorsingOrg(identity view.Identity, org string) (bool, error)
+}
+
+// RevocationReason classifies why an identity was revoked, so that the reason can be surfaced
+// to auditors and, where the driver supports it, published alongside public parameters.
+type RevocationReason int
+
+const (
+	// Unspecified is used when no more precise reason applies.
+	Unspecified RevocationReason = iota
+	// KeyCompromise indicates the identity's private key material is believed to be compromised.
+	KeyCompromise
+	// Superseded indicates the identity was replaced by Rotate.
+	Superseded
+	// CessationOfOperation indicates the identity's owner no longer participates in the TMS.
+	CessationOfOperation
+)
+
+// RevocationRegistry persists an append-only log of identity revocations, queryable at
+// token-verification time to reject tokens issued to, owned by, or audited by a revoked identity.
+type RevocationRegistry interface {
+	// Add appends a revocation record for identity to the log.
+	Add(identity view.Identity, reason RevocationReason) error
+
+	// IsRevoked returns whether identity appears in the log.
+	IsRevoked(identity view.Identity) (bool, error)
+
+	// CRI returns the current credential revocation info, for drivers (e.g. idemix-based ones)
+	// that publish revocation state alongside public parameters. Drivers that have no notion of
+	// a CRI may return nil.
+	CRI() ([]byte, error)
 }
@@ -0,0 +1,57 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package driver
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+)
+
+// RevocationAwareIdentityProvider decorates an IdentityProvider so that Revoke, IsRevoked, and
+// Rotate are actually backed by a RevocationRegistry, and GetSigner refuses to hand out a Signer
+// for an identity the registry has revoked. Without this, Revoke/IsRevoked/Rotate on a plain
+// IdentityProvider would have no log to consult, and revocation would have no effect on signing.
+type RevocationAwareIdentityProvider struct {
+	IdentityProvider
+	Registry RevocationRegistry
+}
+
+// NewRevocationAwareIdentityProvider returns an IdentityProvider that consults registry for
+// Revoke, IsRevoked, and Rotate, while delegating everything else to local.
+func NewRevocationAwareIdentityProvider(local IdentityProvider, registry RevocationRegistry) *RevocationAwareIdentityProvider {
+	return &RevocationAwareIdentityProvider{IdentityProvider: local, Registry: registry}
+}
+
+// Revoke appends a revocation record for identity to the registry.
+func (p *RevocationAwareIdentityProvider) Revoke(identity view.Identity, reason RevocationReason) error {
+	return p.Registry.Add(identity, reason)
+}
+
+// IsRevoked reports whether identity appears in the registry.
+func (p *RevocationAwareIdentityProvider) IsRevoked(identity view.Identity) (bool, error) {
+	return p.Registry.IsRevoked(identity)
+}
+
+// Rotate revokes oldID as Superseded; newID is expected to already be bound via Bind, so that
+// from this point on only newID's signer, verifier, and audit info are honored.
+func (p *RevocationAwareIdentityProvider) Rotate(oldID view.Identity, newID view.Identity) error {
+	return p.Revoke(oldID, Superseded)
+}
+
+// GetSigner returns the wrapped provider's Signer for identity, unless the registry reports
+// identity as revoked, in which case it refuses to sign on its behalf.
+func (p *RevocationAwareIdentityProvider) GetSigner(identity view.Identity) (Signer, error) {
+	revoked, err := p.IsRevoked(identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check revocation status of identity: %w", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("identity is revoked")
+	}
+	return p.IdentityProvider.GetSigner(identity)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package driver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+)
+
+// InMemoryRevocationRegistry is a RevocationRegistry backed by a plain map. It is mainly useful
+// for unit tests and development deployments that do not need revocations to survive a restart;
+// production deployments should use FileRevocationRegistry or an equivalent persistent backend.
+type InMemoryRevocationRegistry struct {
+	mu      sync.RWMutex
+	revoked map[string]RevocationReason
+}
+
+// NewInMemoryRevocationRegistry returns an empty InMemoryRevocationRegistry.
+func NewInMemoryRevocationRegistry() *InMemoryRevocationRegistry {
+	return &InMemoryRevocationRegistry{revoked: map[string]RevocationReason{}}
+}
+
+// Add records identity as revoked for reason; a later Add for the same identity replaces the
+// recorded reason, as no history is kept.
+func (r *InMemoryRevocationRegistry) Add(identity view.Identity, reason RevocationReason) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked[identity.String()] = reason
+	return nil
+}
+
+// IsRevoked returns whether identity appears in the log.
+func (r *InMemoryRevocationRegistry) IsRevoked(identity view.Identity) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.revoked[identity.String()]
+	return ok, nil
+}
+
+// CRI always returns nil; InMemoryRevocationRegistry has no notion of a credential revocation
+// info to publish alongside public parameters.
+func (r *InMemoryRevocationRegistry) CRI() ([]byte, error) {
+	return nil, nil
+}
+
+// revocationRecord is the on-disk, one-line-per-record representation appended to a
+// FileRevocationRegistry's log file.
+type revocationRecord struct {
+	Identity []byte           `json:"identity"`
+	Reason   RevocationReason `json:"reason"`
+}
+
+// FileRevocationRegistry is a RevocationRegistry backed by an append-only, newline-delimited
+// JSON log file, so that revocations survive process restarts and can be audited or shipped
+// alongside the TMS's other generated artifacts.
+type FileRevocationRegistry struct {
+	mu      sync.Mutex
+	path    string
+	revoked map[string]RevocationReason
+}
+
+// NewFileRevocationRegistry returns a FileRevocationRegistry backed by the log file at path,
+// replaying any records already present so IsRevoked reflects prior runs.
+func NewFileRevocationRegistry(path string) (*FileRevocationRegistry, error) {
+	r := &FileRevocationRegistry{path: path, revoked: map[string]RevocationReason{}}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open revocation log [%s]: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec revocationRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("failed to parse revocation log [%s]: %w", path, err)
+		}
+		r.revoked[view.Identity(rec.Identity).String()] = rec.Reason
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read revocation log [%s]: %w", path, err)
+	}
+	return r, nil
+}
+
+// Add appends a revocation record for identity to the log file and its in-memory index.
+func (r *FileRevocationRegistry) Add(identity view.Identity, reason RevocationReason) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	raw, err := json.Marshal(revocationRecord{Identity: identity, Reason: reason})
+	if err != nil {
+		return fmt.Errorf("failed to marshal revocation record: %w", err)
+	}
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open revocation log [%s]: %w", r.path, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(raw, '\n')); err != nil {
+		return fmt.Errorf("failed to append to revocation log [%s]: %w", r.path, err)
+	}
+	r.revoked[identity.String()] = reason
+	return nil
+}
+
+// IsRevoked returns whether identity appears in the log.
+func (r *FileRevocationRegistry) IsRevoked(identity view.Identity) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.revoked[identity.String()]
+	return ok, nil
+}
+
+// CRI always returns nil; FileRevocationRegistry has no notion of a credential revocation info
+// to publish alongside public parameters.
+func (r *FileRevocationRegistry) CRI() ([]byte, error) {
+	return nil, nil
+}
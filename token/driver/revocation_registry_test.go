@@ -0,0 +1,62 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package driver_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRegistryRoundTrip(t *testing.T, r driver.RevocationRegistry) {
+	identity := view.Identity("alice")
+
+	revoked, err := r.IsRevoked(identity)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	assert.NoError(t, r.Add(identity, driver.KeyCompromise))
+
+	revoked, err = r.IsRevoked(identity)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestInMemoryRevocationRegistry(t *testing.T) {
+	testRegistryRoundTrip(t, driver.NewInMemoryRevocationRegistry())
+}
+
+func TestFileRevocationRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revocations.jsonl")
+	r, err := driver.NewFileRevocationRegistry(path)
+	assert.NoError(t, err)
+	testRegistryRoundTrip(t, r)
+
+	reopened, err := driver.NewFileRevocationRegistry(path)
+	assert.NoError(t, err)
+	revoked, err := reopened.IsRevoked(view.Identity("alice"))
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestRevocationAwareIdentityProviderBlocksRevokedSigner(t *testing.T) {
+	registry := driver.NewInMemoryRevocationRegistry()
+	p := driver.NewRevocationAwareIdentityProvider(nil, registry)
+
+	identity := view.Identity("bob")
+	assert.NoError(t, p.Revoke(identity, driver.KeyCompromise))
+
+	revoked, err := p.IsRevoked(identity)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	_, err = p.GetSigner(identity)
+	assert.Error(t, err)
+}
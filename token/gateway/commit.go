@@ -0,0 +1,85 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/fabric"
+)
+
+// CommitHandler decides whether a submitted transaction has been
+// sufficiently endorsed/committed before Transaction.Submit returns control
+// to the caller. endorsingOrgs is the list of organization MSP IDs (possibly
+// with repeats, one per selected peer) the transaction selected for
+// endorsement, either picked by SelectEndorsers or pinned via
+// WithEndorsingPeers, after filtering by CheckEndorsingOrg; it is not read
+// back from the invocation's actual endorsements.
+type CommitHandler func(entry *fabric.Entry, endorsingOrgs []string) error
+
+// orgCounts tallies how many endorsing peers were seen per organization.
+func orgCounts(endorsingOrgs []string) map[string]int {
+	counts := make(map[string]int, len(endorsingOrgs))
+	for _, org := range endorsingOrgs {
+		counts[org]++
+	}
+	return counts
+}
+
+// missingOrgs returns the orgs in required that have no entry in counts.
+func missingOrgs(required []string, counts map[string]int) []string {
+	var missing []string
+	for _, org := range required {
+		if counts[org] == 0 {
+			missing = append(missing, org)
+		}
+	}
+	return missing
+}
+
+// OrgAll requires every organization hosting the chaincode to have endorsed
+// before a submit is considered committed. This is the default strategy.
+func OrgAll(entry *fabric.Entry, endorsingOrgs []string) error {
+	counts := orgCounts(endorsingOrgs)
+	if missing := missingOrgs(entry.TMS.TokenChaincode.Orgs, counts); len(missing) > 0 {
+		return fmt.Errorf("gateway: transaction not committed, missing endorsement from orgs %v", missing)
+	}
+	return nil
+}
+
+// OrgAny requires only a single organization's endorsement before a submit
+// is considered committed, useful for flows where any one endorser is
+// sufficient (e.g. a single-issuer namespace).
+func OrgAny(entry *fabric.Entry, endorsingOrgs []string) error {
+	if len(endorsingOrgs) == 0 {
+		return fmt.Errorf("gateway: transaction not committed, no endorsing peers")
+	}
+	return nil
+}
+
+// NetworkScopeAllFortune requires every organization hosting this namespace's chaincode to have
+// endorsed, like OrgAll, but additionally requires at least two independent endorsing peers per
+// org for redundancy — the strictest of the three strategies, for flows that must tolerate a
+// single endorsing peer going down without losing commitment. It does not reach beyond the
+// chaincode's own orgs to the rest of the network's topology; entry carries no information about
+// orgs that do not host the chaincode, so there is nothing broader it could check against.
+func NetworkScopeAllFortune(entry *fabric.Entry, endorsingOrgs []string) error {
+	counts := orgCounts(endorsingOrgs)
+	if missing := missingOrgs(entry.TMS.TokenChaincode.Orgs, counts); len(missing) > 0 {
+		return fmt.Errorf("gateway: transaction not committed, missing endorsement from orgs %v", missing)
+	}
+	var underReplicated []string
+	for _, org := range entry.TMS.TokenChaincode.Orgs {
+		if counts[org] < 2 {
+			underReplicated = append(underReplicated, org)
+		}
+	}
+	if len(underReplicated) > 0 {
+		return fmt.Errorf("gateway: transaction not committed, need at least 2 endorsing peers per org, short for %v", underReplicated)
+	}
+	return nil
+}
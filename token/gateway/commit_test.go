@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/fabric"
+	topology2 "github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+func entryWithOrgs(orgs ...string) *fabric.Entry {
+	tms := &topology2.TMS{}
+	tms.TokenChaincode.Orgs = orgs
+	return &fabric.Entry{TMS: tms}
+}
+
+func TestOrgCounts(t *testing.T) {
+	counts := orgCounts([]string{"Org1MSP", "Org2MSP", "Org1MSP"})
+	assert.Equal(t, map[string]int{"Org1MSP": 2, "Org2MSP": 1}, counts)
+}
+
+func TestMissingOrgs(t *testing.T) {
+	counts := orgCounts([]string{"Org1MSP"})
+	assert.Equal(t, []string{"Org2MSP"}, missingOrgs([]string{"Org1MSP", "Org2MSP"}, counts))
+	assert.Nil(t, missingOrgs([]string{"Org1MSP"}, counts))
+}
+
+func TestOrgAll(t *testing.T) {
+	entry := entryWithOrgs("Org1MSP", "Org2MSP")
+	assert.NoError(t, OrgAll(entry, []string{"Org1MSP", "Org2MSP"}))
+	assert.Error(t, OrgAll(entry, []string{"Org1MSP"}))
+}
+
+func TestOrgAny(t *testing.T) {
+	entry := entryWithOrgs("Org1MSP", "Org2MSP")
+	assert.NoError(t, OrgAny(entry, []string{"Org1MSP"}))
+	assert.Error(t, OrgAny(entry, nil))
+}
+
+func TestNetworkScopeAllFortune(t *testing.T) {
+	entry := entryWithOrgs("Org1MSP", "Org2MSP")
+
+	assert.Error(t, NetworkScopeAllFortune(entry, []string{"Org1MSP"}))
+	assert.Error(t, NetworkScopeAllFortune(entry, []string{"Org1MSP", "Org2MSP"}))
+	assert.NoError(t, NetworkScopeAllFortune(entry, []string{"Org1MSP", "Org1MSP", "Org2MSP", "Org2MSP"}))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/fabric"
+)
+
+// Contract invokes a single token chaincode namespace on behalf of a Network.
+type Contract struct {
+	network *Network
+	entry   *fabric.Entry
+}
+
+// TransactionOption configures a Transaction built by CreateTransaction.
+type TransactionOption func(*Transaction)
+
+// WithTransient stores transient data on the transaction. The data is not
+// passed to the chaincode invocation, which takes only the method name and
+// arguments.
+func WithTransient(transient map[string][]byte) TransactionOption {
+	return func(t *Transaction) { t.transient = transient }
+}
+
+// WithEndorsingPeers pins the organizations (MSP IDs) handed to the
+// Gateway's CommitHandler instead of letting SelectEndorsers pick them. The
+// chaincode invocation itself is not routed to these organizations' peers.
+func WithEndorsingPeers(orgs ...string) TransactionOption {
+	return func(t *Transaction) { t.endorsingOrgs = orgs }
+}
+
+// Transaction is a single chaincode invocation built by CreateTransaction and
+// dispatched with Submit or Evaluate.
+type Transaction struct {
+	contract      *Contract
+	name          string
+	transient     map[string][]byte
+	endorsingOrgs []string
+}
+
+// CreateTransaction returns a Transaction invoking method name, configured
+// with opts, ready to Submit or Evaluate.
+func (c *Contract) CreateTransaction(name string, opts ...TransactionOption) *Transaction {
+	t := &Transaction{contract: c, name: name}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Submit invokes the transaction's method with args and waits for the
+// contract's Gateway.commitHandler to consider it committed.
+func (t *Transaction) Submit(args ...[]byte) ([]byte, error) {
+	result, err := t.invoke(args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.contract.network.gateway.commitHandler(t.contract.entry, t.endorsingOrgs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Evaluate invokes the transaction's method with args without waiting for
+// commit, for read-only queries.
+func (t *Transaction) Evaluate(args ...[]byte) ([]byte, error) {
+	return t.invoke(args...)
+}
+
+func (t *Transaction) invoke(args ...[]byte) ([]byte, error) {
+	gw := t.contract.network.gateway
+	tms := t.contract.entry.TMS
+	chaincode := t.contract.entry.TCC.Chaincode
+	nh := gw.networkHandler
+
+	// Resolve an endorser set via SelectEndorsers (discovery-backed where the network offers it,
+	// topology-derived otherwise) when the caller didn't pin one via WithEndorsingPeers. The set
+	// only feeds the CommitHandler; InvokeChaincode below keeps its default routing either way.
+	if len(t.endorsingOrgs) == 0 {
+		endorsers, err := nh.SelectEndorsers(tms, tms.Namespace, "")
+		if err != nil {
+			logger.Debugf("gateway: discovery-backed endorser selection unavailable for [%s:%s], falling back to default routing: %s", tms.Channel, tms.Namespace, err)
+		} else {
+			for _, e := range endorsers {
+				t.endorsingOrgs = append(t.endorsingOrgs, e.MSPID)
+			}
+		}
+	}
+
+	if err := t.filterEndorsingOrgs(); err != nil {
+		return nil, err
+	}
+
+	sigma, err := t.sign(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return nh.Fabric(tms).InvokeChaincode(chaincode, t.name, append(args, sigma)...), nil
+}
+
+// filterEndorsingOrgs drops, from t.endorsingOrgs, any org the Gateway's configured identity is
+// not allowed to ask for endorsement from, per driver.IdentityProvider.CheckEndorsingOrg. This is
+// how issuer-only or auditor-only flows keep sensitive invocations away from orgs that shouldn't
+// see them, independent of the chaincode-level endorsement policy.
+func (t *Transaction) filterEndorsingOrgs() error {
+	gw := t.contract.network.gateway
+	allowed := t.endorsingOrgs[:0]
+	for _, org := range t.endorsingOrgs {
+		ok, err := gw.identityProvider.CheckEndorsingOrg(gw.identity.ID, org)
+		if err != nil {
+			return fmt.Errorf("failed to check endorsing org [%s]: %w", org, err)
+		}
+		if ok {
+			allowed = append(allowed, org)
+		}
+	}
+	t.endorsingOrgs = allowed
+	return nil
+}
+
+// sign signs name+args on behalf of the Gateway's configured identity, using
+// driver.IdentityProvider.GetSigner so that key material for the submitting
+// identity never has to be held by this package.
+func (t *Transaction) sign(args [][]byte) ([]byte, error) {
+	gw := t.contract.network.gateway
+	signer, err := gw.identityProvider.GetSigner(gw.identity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signer for identity [%s]: %w", gw.identity.ID, err)
+	}
+
+	payload := bytes.NewBufferString(t.name)
+	for _, arg := range args {
+		payload.Write(arg)
+	}
+	sigma, err := signer.Sign(payload.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction [%s]: %w", t.name, err)
+	}
+	return sigma, nil
+}
+
+// SubmitTransaction is shorthand for CreateTransaction(name).Submit(args...).
+func (c *Contract) SubmitTransaction(name string, args ...[]byte) ([]byte, error) {
+	return c.CreateTransaction(name).Submit(args...)
+}
+
+// EvaluateTransaction is shorthand for CreateTransaction(name).Evaluate(args...).
+func (c *Contract) EvaluateTransaction(name string, args ...[]byte) ([]byte, error) {
+	return c.CreateTransaction(name).Evaluate(args...)
+}
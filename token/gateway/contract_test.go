@@ -0,0 +1,58 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeIdentityProvider implements driver.IdentityProvider with only
+// CheckEndorsingOrg wired up, for exercising filterEndorsingOrgs in isolation.
+type fakeIdentityProvider struct {
+	driver.IdentityProvider
+	allowed map[string]bool
+}
+
+func (f *fakeIdentityProvider) CheckEndorsingOrg(identity view.Identity, org string) (bool, error) {
+	return f.allowed[org], nil
+}
+
+type erroringIdentityProvider struct {
+	driver.IdentityProvider
+}
+
+func (erroringIdentityProvider) CheckEndorsingOrg(identity view.Identity, org string) (bool, error) {
+	return false, fmt.Errorf("boom")
+}
+
+func transactionWith(ip driver.IdentityProvider, orgs ...string) *Transaction {
+	gw := &Gateway{identityProvider: ip, identity: wallet.Identity{ID: view.Identity("alice")}}
+	return &Transaction{
+		contract:      &Contract{network: &Network{gateway: gw}},
+		endorsingOrgs: orgs,
+	}
+}
+
+func TestFilterEndorsingOrgsDropsDisallowed(t *testing.T) {
+	ip := &fakeIdentityProvider{allowed: map[string]bool{"Org1MSP": true}}
+	txn := transactionWith(ip, "Org1MSP", "Org2MSP")
+
+	assert.NoError(t, txn.filterEndorsingOrgs())
+	assert.Equal(t, []string{"Org1MSP"}, txn.endorsingOrgs)
+}
+
+func TestFilterEndorsingOrgsPropagatesError(t *testing.T) {
+	txn := transactionWith(erroringIdentityProvider{}, "Org1MSP")
+
+	assert.Error(t, txn.filterEndorsingOrgs())
+}
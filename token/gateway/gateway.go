@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gateway offers a Gateway/Network/Contract abstraction on top of
+// fabric.NetworkHandler, mirroring the fabric-sdk-go Gateway pattern so that
+// integration tests and end-users can write concise token flows instead of
+// hand-rolling chaincode invocations.
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/services/flogging"
+	"github.com/hyperledger-labs/fabric-token-sdk/integration/nwo/token/fabric"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/wallet"
+)
+
+var logger = flogging.MustGetLogger("token.gateway")
+
+// Config identifies the network handler and identity provider a Gateway uses; the TMS is picked
+// later through GetNetwork and GetContract.
+type Config struct {
+	// NetworkHandler drives chaincode deployment and invocation for TMS.
+	NetworkHandler *fabric.NetworkHandler
+	// IdentityProvider resolves the Signer used to sign submitted transactions.
+	IdentityProvider driver.IdentityProvider
+}
+
+// Option configures a Gateway at Connect time.
+type Option func(*Gateway)
+
+// WithIdentity sets the identity used to sign transactions submitted through
+// the Gateway. identity.ID is passed to IdentityProvider.GetSigner on every
+// Submit/Evaluate call.
+func WithIdentity(identity wallet.Identity) Option {
+	return func(g *Gateway) { g.identity = identity }
+}
+
+// WithCommitHandler sets the strategy used to decide a transaction has been
+// sufficiently committed before SubmitTransaction returns.
+func WithCommitHandler(handler CommitHandler) Option {
+	return func(g *Gateway) { g.commitHandler = handler }
+}
+
+// Gateway is the entry point into the fabric-token-sdk Gateway API. It wraps
+// a fabric.NetworkHandler and the identity used to sign outgoing
+// transactions.
+type Gateway struct {
+	networkHandler   *fabric.NetworkHandler
+	identityProvider driver.IdentityProvider
+	identity         wallet.Identity
+	commitHandler    CommitHandler
+}
+
+// Connect returns a Gateway bound to cfg.NetworkHandler, signing
+// transactions with the identity and commit strategy given via opts. If no
+// CommitHandler is supplied, OrgAll is used.
+func Connect(cfg Config, opts ...Option) (*Gateway, error) {
+	if cfg.NetworkHandler == nil {
+		return nil, fmt.Errorf("gateway: no NetworkHandler supplied in config")
+	}
+	if cfg.IdentityProvider == nil {
+		return nil, fmt.Errorf("gateway: no IdentityProvider supplied in config")
+	}
+	g := &Gateway{
+		networkHandler:   cfg.NetworkHandler,
+		identityProvider: cfg.IdentityProvider,
+		commitHandler:    OrgAll,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
+
+// GetNetwork returns the Network backing the given channel.
+func (g *Gateway) GetNetwork(channel string) *Network {
+	return &Network{gateway: g, channel: channel}
+}
@@ -0,0 +1,28 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import "fmt"
+
+// Network scopes Gateway operations to a single Fabric channel.
+type Network struct {
+	gateway *Gateway
+	channel string
+}
+
+// GetContract returns the Contract for namespace on this Network's channel.
+// It looks up the TMS entry the gateway's NetworkHandler already generated
+// artifacts for; GenerateArtifacts must have run before GetContract is
+// called.
+func (n *Network) GetContract(namespace string) (*Contract, error) {
+	for _, entry := range n.gateway.networkHandler.Entries {
+		if entry.TMS.Channel == n.channel && entry.TMS.Namespace == namespace {
+			return &Contract{network: n, entry: entry}, nil
+		}
+	}
+	return nil, fmt.Errorf("gateway: no TMS found for channel [%s] namespace [%s]", n.channel, namespace)
+}
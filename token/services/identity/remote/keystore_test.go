@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/identity/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileKeyStore(t *testing.T) {
+	ks, err := remote.NewFileKeyStore(filepath.Join(t.TempDir(), "keystore"))
+	assert.NoError(t, err)
+
+	assert.False(t, ks.Has("alice"))
+
+	id, err := ks.New("alice")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+	assert.True(t, ks.Has("alice"))
+
+	labels, err := ks.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, labels)
+
+	signer, err := ks.Signer("alice")
+	assert.NoError(t, err)
+	sigma, err := signer.Sign([]byte("hello"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, sigma)
+
+	assert.NoError(t, ks.Delete("alice"))
+	assert.False(t, ks.Has("alice"))
+}
+
+func TestFileKeyStoreRejectsPathTraversal(t *testing.T) {
+	ks, err := remote.NewFileKeyStore(filepath.Join(t.TempDir(), "keystore"))
+	assert.NoError(t, err)
+
+	label := "../../../../etc/cron.d/x"
+	assert.False(t, ks.Has(label))
+
+	_, err = ks.New(label)
+	assert.Error(t, err)
+
+	assert.Error(t, ks.Delete(label))
+
+	_, err = ks.Signer(label)
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package remote provides a RemoteSigner that keeps private key material out
+// of the token service process, forwarding Sign calls to a separate wallet
+// daemon (see cmd/token-wallet) over a small JSON-RPC protocol.
+package remote
+
+import (
+	"fmt"
+	"net/rpc/jsonrpc"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+)
+
+// SignArgs is the request payload for the WalletSign RPC method.
+type SignArgs struct {
+	Token      string
+	IdentityID string
+	Message    []byte
+}
+
+// SignReply is the response payload for the WalletSign RPC method.
+type SignReply struct {
+	Signature []byte
+}
+
+// RemoteSigner is a driver.Signer that forwards Sign calls to a wallet daemon
+// hosted at Endpoint, rather than holding key material in this process.
+type RemoteSigner struct {
+	Endpoint   string
+	IdentityID string
+	// Token is the shared secret presented as every RPC call's authentication, matching the
+	// wallet daemon's configured Wallet.Token.
+	Token string
+}
+
+// NewRemoteSigner returns a Signer that delegates to the wallet daemon listening at endpoint,
+// authenticating with token, for the identity identified by identityID.
+func NewRemoteSigner(endpoint string, identityID string, token string) *RemoteSigner {
+	return &RemoteSigner{Endpoint: endpoint, IdentityID: identityID, Token: token}
+}
+
+// Sign forwards msg to the wallet daemon and returns the resulting signature.
+func (s *RemoteSigner) Sign(msg []byte) ([]byte, error) {
+	client, err := jsonrpc.Dial("tcp", s.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to wallet daemon at [%s]: %w", s.Endpoint, err)
+	}
+	defer client.Close()
+
+	reply := &SignReply{}
+	args := &SignArgs{Token: s.Token, IdentityID: s.IdentityID, Message: msg}
+	if err := client.Call("Wallet.WalletSign", args, reply); err != nil {
+		return nil, fmt.Errorf("failed to sign with remote identity [%s]: %w", s.IdentityID, err)
+	}
+	return reply.Signature, nil
+}
+
+// Config describes how to reach the remote signer for a single identity.
+// The identity's audit info and metadata keep being served locally; only the
+// signing operation is proxied to the daemon.
+type Config struct {
+	// Endpoint is the address (host:port) of the wallet daemon.
+	Endpoint string
+	// IdentityID is the identity the daemon should sign on behalf of.
+	IdentityID string
+	// Usage is the role IdentityID was registered under (IssuerRole, AuditorRole, OwnerRole, or
+	// CertifierRole), so GetSigner can look it up regardless of which role it plays.
+	Usage driver.IdentityUsage
+	// Token is the shared secret presented to the wallet daemon, matching its configured
+	// Wallet.Token; without it the daemon rejects every call.
+	Token string
+}
+
+// IdentityProvider decorates a local driver.IdentityProvider so that
+// GetSigner is proxied to a remote wallet daemon while Bind, GetAuditInfo,
+// and GetIdentityMetadata keep resolving against the local provider.
+type IdentityProvider struct {
+	driver.IdentityProvider
+	Config Config
+}
+
+// NewIdentityProvider returns an IdentityProvider that proxies signing for
+// identity ids matching cfg.IdentityID to the remote daemon at cfg.Endpoint,
+// falling back to local signing for every other identity.
+func NewIdentityProvider(local driver.IdentityProvider, cfg Config) *IdentityProvider {
+	return &IdentityProvider{IdentityProvider: local, Config: cfg}
+}
+
+// GetSigner returns a RemoteSigner when identity matches the configured
+// remote identity, otherwise it falls back to the wrapped local provider.
+func (p *IdentityProvider) GetSigner(identity view.Identity) (driver.Signer, error) {
+	info := p.IdentityProvider.GetIdentityInfo(p.Config.Usage, p.Config.IdentityID)
+	if info == nil {
+		return p.IdentityProvider.GetSigner(identity)
+	}
+	localIdentity, err := info.GetIdentity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve local identity [%s]: %w", p.Config.IdentityID, err)
+	}
+	if !localIdentity.Equal(identity) {
+		return p.IdentityProvider.GetSigner(identity)
+	}
+	return NewRemoteSigner(p.Config.Endpoint, p.Config.IdentityID, p.Config.Token), nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+)
+
+// KeyStore gives the wallet daemon access to the key material backing the
+// identities it serves. Implementations may be on-disk, HSM-backed, or
+// anything else that can produce a Signer and a view.Identity for a label.
+type KeyStore interface {
+	// New generates a fresh key pair for label and returns its public identity.
+	New(label string) (view.Identity, error)
+	// List returns the labels of every identity held by the store.
+	List() ([]string, error)
+	// Has returns whether label is present in the store.
+	Has(label string) bool
+	// Delete removes the key material for label, if present.
+	Delete(label string) error
+	// Signer returns a driver.Signer able to sign on behalf of label.
+	Signer(label string) (driver.Signer, error)
+}
+
+// Wallet is the RPC service hosted by cmd/token-wallet. Its method set
+// follows the net/rpc convention: func (t *Wallet) Name(args *A, reply *R) error.
+type Wallet struct {
+	KeyStore KeyStore
+	// Token is the shared secret every RPC call must present in its Args.Token field. Without
+	// it, any process able to reach Serve's listener could sign arbitrary messages with, or
+	// delete, any identity this daemon holds; callers not presenting the configured Token are
+	// rejected before KeyStore is ever consulted.
+	Token string
+}
+
+// NewWallet returns a Wallet RPC service backed by ks, requiring token on every call.
+func NewWallet(ks KeyStore, token string) *Wallet {
+	return &Wallet{KeyStore: ks, Token: token}
+}
+
+// authenticate rejects a call whose token does not match w.Token, comparing in constant time so
+// the daemon's response cannot be used to guess the token byte by byte.
+func (w *Wallet) authenticate(token string) error {
+	if subtle.ConstantTimeCompare([]byte(token), []byte(w.Token)) != 1 {
+		return fmt.Errorf("authentication failed")
+	}
+	return nil
+}
+
+// WalletNewArgs is the request payload for the WalletNew RPC method.
+type WalletNewArgs struct {
+	Token string
+	Label string
+}
+
+// WalletNewReply is the response payload for the WalletNew RPC method.
+type WalletNewReply struct {
+	Identity view.Identity
+}
+
+// WalletNew generates a new identity under the given label.
+func (w *Wallet) WalletNew(args *WalletNewArgs, reply *WalletNewReply) error {
+	if err := w.authenticate(args.Token); err != nil {
+		return err
+	}
+	id, err := w.KeyStore.New(args.Label)
+	if err != nil {
+		return fmt.Errorf("failed to create identity [%s]: %w", args.Label, err)
+	}
+	reply.Identity = id
+	return nil
+}
+
+// WalletListArgs is the request payload for the WalletList RPC method.
+type WalletListArgs struct {
+	Token string
+}
+
+// WalletListReply is the response payload for the WalletList RPC method.
+type WalletListReply struct {
+	Labels []string
+}
+
+// WalletList returns the labels of every identity held by the daemon.
+func (w *Wallet) WalletList(args *WalletListArgs, reply *WalletListReply) error {
+	if err := w.authenticate(args.Token); err != nil {
+		return err
+	}
+	labels, err := w.KeyStore.List()
+	if err != nil {
+		return fmt.Errorf("failed to list identities: %w", err)
+	}
+	reply.Labels = labels
+	return nil
+}
+
+// WalletHasArgs is the request payload for the WalletHas RPC method.
+type WalletHasArgs struct {
+	Token string
+	Label string
+}
+
+// WalletHasReply is the response payload for the WalletHas RPC method.
+type WalletHasReply struct {
+	Found bool
+}
+
+// WalletHas reports whether the daemon holds an identity for the given label.
+func (w *Wallet) WalletHas(args *WalletHasArgs, reply *WalletHasReply) error {
+	if err := w.authenticate(args.Token); err != nil {
+		return err
+	}
+	reply.Found = w.KeyStore.Has(args.Label)
+	return nil
+}
+
+// WalletDeleteArgs is the request payload for the WalletDelete RPC method.
+type WalletDeleteArgs struct {
+	Token string
+	Label string
+}
+
+// WalletDeleteReply is the (empty) response payload for the WalletDelete RPC method.
+type WalletDeleteReply struct{}
+
+// WalletDelete removes the identity held for the given label.
+func (w *Wallet) WalletDelete(args *WalletDeleteArgs, reply *WalletDeleteReply) error {
+	if err := w.authenticate(args.Token); err != nil {
+		return err
+	}
+	if err := w.KeyStore.Delete(args.Label); err != nil {
+		return fmt.Errorf("failed to delete identity [%s]: %w", args.Label, err)
+	}
+	return nil
+}
+
+// WalletSign signs args.Message on behalf of args.IdentityID.
+func (w *Wallet) WalletSign(args *SignArgs, reply *SignReply) error {
+	if err := w.authenticate(args.Token); err != nil {
+		return err
+	}
+	signer, err := w.KeyStore.Signer(args.IdentityID)
+	if err != nil {
+		return fmt.Errorf("failed to load signer for [%s]: %w", args.IdentityID, err)
+	}
+	sigma, err := signer.Sign(args.Message)
+	if err != nil {
+		return fmt.Errorf("failed to sign with [%s]: %w", args.IdentityID, err)
+	}
+	reply.Signature = sigma
+	return nil
+}
+
+// Serve registers w and blocks serving JSON-RPC requests received on listenAddr. When tlsConfig
+// is non-nil, every accepted connection is wrapped with it before JSON-RPC is served on top; set
+// its ClientAuth to tls.RequireAndVerifyClientCert for mutual TLS. Pass nil to serve plain TCP and
+// rely on w.Token alone, which is always checked regardless of tlsConfig.
+func Serve(w *Wallet, listenAddr string, tlsConfig *tls.Config) error {
+	if err := rpc.RegisterName("Wallet", w); err != nil {
+		return fmt.Errorf("failed to register wallet service: %w", err)
+	}
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on [%s]: %w", listenAddr, err)
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+		go jsonrpc.ServeConn(conn)
+	}
+}
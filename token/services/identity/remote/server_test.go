@@ -0,0 +1,37 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/identity/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletRejectsWrongToken(t *testing.T) {
+	ks, err := remote.NewFileKeyStore(filepath.Join(t.TempDir(), "keystore"))
+	assert.NoError(t, err)
+	w := remote.NewWallet(ks, "correct-secret")
+
+	reply := &remote.WalletNewReply{}
+	err = w.WalletNew(&remote.WalletNewArgs{Token: "wrong-secret", Label: "alice"}, reply)
+	assert.Error(t, err)
+	assert.False(t, ks.Has("alice"))
+}
+
+func TestWalletAcceptsCorrectToken(t *testing.T) {
+	ks, err := remote.NewFileKeyStore(filepath.Join(t.TempDir(), "keystore"))
+	assert.NoError(t, err)
+	w := remote.NewWallet(ks, "correct-secret")
+
+	reply := &remote.WalletNewReply{}
+	err = w.WalletNew(&remote.WalletNewArgs{Token: "correct-secret", Label: "alice"}, reply)
+	assert.NoError(t, err)
+	assert.True(t, ks.Has("alice"))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+)
+
+// FileSystemWallet is a Wallet that persists one JSON file per label under
+// Path. It can receive a copy of the identities the integration-test crypto
+// generator writes to its own fixed on-disk layout, and lets production
+// deployments point at a pre-provisioned directory instead.
+type FileSystemWallet struct {
+	Path string
+}
+
+// fileSystemEntry is the on-disk representation of an Identity.
+type fileSystemEntry struct {
+	ID   []byte       `json:"id"`
+	Data IdentityData `json:"data"`
+}
+
+// NewFileSystemWallet returns a FileSystemWallet rooted at path, creating it
+// if it does not already exist.
+func NewFileSystemWallet(path string) (*FileSystemWallet, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create wallet directory [%s]: %w", path, err)
+	}
+	return &FileSystemWallet{Path: path}, nil
+}
+
+func (w *FileSystemWallet) entryFile(label string) string {
+	return filepath.Join(w.Path, label+".json")
+}
+
+// validLabel rejects labels that are empty or would resolve outside w.Path once joined, since a
+// value like "../../etc/passwd" would otherwise let a caller read or write arbitrary files.
+func validLabel(label string) bool {
+	return label != "" && filepath.Base(label) == label
+}
+
+func (w *FileSystemWallet) Put(label string, id Identity) error {
+	if !validLabel(label) {
+		return fmt.Errorf("invalid label [%s]", label)
+	}
+	raw, err := json.Marshal(fileSystemEntry{ID: id.ID, Data: id.Data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal identity [%s]: %w", label, err)
+	}
+	if err := os.WriteFile(w.entryFile(label), raw, 0644); err != nil {
+		return fmt.Errorf("failed to persist identity [%s]: %w", label, err)
+	}
+	return nil
+}
+
+func (w *FileSystemWallet) Get(label string) (Identity, error) {
+	if !validLabel(label) {
+		return Identity{}, fmt.Errorf("invalid label [%s]", label)
+	}
+	raw, err := os.ReadFile(w.entryFile(label))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Identity{}, &ErrNotFound{Label: label}
+		}
+		return Identity{}, fmt.Errorf("failed to read identity [%s]: %w", label, err)
+	}
+	var entry fileSystemEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return Identity{}, fmt.Errorf("failed to unmarshal identity [%s]: %w", label, err)
+	}
+	return Identity{ID: view.Identity(entry.ID), Data: entry.Data}, nil
+}
+
+func (w *FileSystemWallet) List() ([]string, error) {
+	entries, err := os.ReadDir(w.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet [%s]: %w", w.Path, err)
+	}
+	var labels []string
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".json" {
+			labels = append(labels, e.Name()[:len(e.Name())-len(".json")])
+		}
+	}
+	return labels, nil
+}
+
+func (w *FileSystemWallet) Remove(label string) error {
+	if !validLabel(label) {
+		return fmt.Errorf("invalid label [%s]", label)
+	}
+	if err := os.Remove(w.entryFile(label)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove identity [%s]: %w", label, err)
+	}
+	return nil
+}
+
+func (w *FileSystemWallet) Exists(label string) bool {
+	if !validLabel(label) {
+		return false
+	}
+	_, err := os.Stat(w.entryFile(label))
+	return err == nil
+}
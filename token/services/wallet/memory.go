@@ -0,0 +1,63 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import "sync"
+
+// InMemoryWallet is a Wallet backed by a plain map. It is mainly useful for
+// unit tests and for integration-test crypto generators that do not need
+// identities to survive process restarts.
+type InMemoryWallet struct {
+	mu    sync.RWMutex
+	store map[string]Identity
+}
+
+// NewInMemoryWallet returns an empty InMemoryWallet.
+func NewInMemoryWallet() *InMemoryWallet {
+	return &InMemoryWallet{store: map[string]Identity{}}
+}
+
+func (w *InMemoryWallet) Put(label string, id Identity) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.store[label] = id
+	return nil
+}
+
+func (w *InMemoryWallet) Get(label string) (Identity, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	id, ok := w.store[label]
+	if !ok {
+		return Identity{}, &ErrNotFound{Label: label}
+	}
+	return id, nil
+}
+
+func (w *InMemoryWallet) List() ([]string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	labels := make([]string, 0, len(w.store))
+	for label := range w.store {
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+func (w *InMemoryWallet) Remove(label string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.store, label)
+	return nil
+}
+
+func (w *InMemoryWallet) Exists(label string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	_, ok := w.store[label]
+	return ok
+}
@@ -0,0 +1,201 @@
+//go:build pkcs11
+// +build pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+// pkcs11Application tags the CKO_DATA objects this wallet owns on the token,
+// so List/Get/Remove never touch objects created by anything else sharing
+// the slot.
+const pkcs11Application = "fabric-token-sdk/wallet"
+
+// PKCS11Config describes how to reach an HSM's PKCS#11 module and which slot
+// to use. Labels map to object labels on the token; no key material ever
+// leaves the HSM boundary.
+type PKCS11Config struct {
+	// Library is the path to the vendor's PKCS#11 shared library.
+	Library string
+	// Label identifies the token/slot to open.
+	Label string
+	// Pin authenticates the session against the token.
+	Pin string
+}
+
+// PKCS11Wallet is a Wallet backed by an HSM accessed through PKCS#11. Every
+// Identity is persisted as a token-resident CKO_DATA object rather than in
+// process memory, so entries survive process restarts, as with the
+// filesystem backend and unlike the in-memory one.
+type PKCS11Wallet struct {
+	cfg     PKCS11Config
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+// NewPKCS11Wallet opens a session against the HSM described by cfg.
+func NewPKCS11Wallet(cfg PKCS11Config) (*PKCS11Wallet, error) {
+	ctx := pkcs11.New(cfg.Library)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 library [%s]", cfg.Library)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module [%s]: %w", cfg.Library, err)
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	var slot uint
+	found := false
+	for _, s := range slots {
+		info, err := ctx.GetTokenInfo(s)
+		if err == nil && info.Label == cfg.Label {
+			slot = s
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no PKCS#11 token found with label [%s]", cfg.Label)
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session on slot [%d]: %w", slot, err)
+	}
+	if err := ctx.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil {
+		return nil, fmt.Errorf("failed to login to PKCS#11 token [%s]: %w", cfg.Label, err)
+	}
+	return &PKCS11Wallet{cfg: cfg, ctx: ctx, session: session}, nil
+}
+
+// findObject returns the handle of the CKO_DATA object stored under label,
+// or ok=false if none exists.
+func (w *PKCS11Wallet) findObject(label string) (pkcs11.ObjectHandle, bool, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, pkcs11Application),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := w.ctx.FindObjectsInit(w.session, template); err != nil {
+		return 0, false, fmt.Errorf("failed to search PKCS#11 token for [%s]: %w", label, err)
+	}
+	defer w.ctx.FindObjectsFinal(w.session)
+
+	handles, _, err := w.ctx.FindObjects(w.session, 1)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to search PKCS#11 token for [%s]: %w", label, err)
+	}
+	if len(handles) == 0 {
+		return 0, false, nil
+	}
+	return handles[0], true, nil
+}
+
+func (w *PKCS11Wallet) Put(label string, id Identity) error {
+	if existing, ok, err := w.findObject(label); err != nil {
+		return err
+	} else if ok {
+		if err := w.ctx.DestroyObject(w.session, existing); err != nil {
+			return fmt.Errorf("failed to overwrite identity [%s]: %w", label, err)
+		}
+	}
+
+	raw, err := json.Marshal(id)
+	if err != nil {
+		return fmt.Errorf("failed to marshal identity [%s]: %w", label, err)
+	}
+
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, pkcs11Application),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, raw),
+	}
+	if _, err := w.ctx.CreateObject(w.session, template); err != nil {
+		return fmt.Errorf("failed to persist identity [%s] on HSM: %w", label, err)
+	}
+	return nil
+}
+
+func (w *PKCS11Wallet) Get(label string) (Identity, error) {
+	handle, ok, err := w.findObject(label)
+	if err != nil {
+		return Identity{}, err
+	}
+	if !ok {
+		return Identity{}, &ErrNotFound{Label: label}
+	}
+
+	attrs, err := w.ctx.GetAttributeValue(w.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to read identity [%s] from HSM: %w", label, err)
+	}
+
+	var id Identity
+	if err := json.Unmarshal(attrs[0].Value, &id); err != nil {
+		return Identity{}, fmt.Errorf("failed to unmarshal identity [%s]: %w", label, err)
+	}
+	return id, nil
+}
+
+func (w *PKCS11Wallet) List() ([]string, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, pkcs11Application),
+	}
+	if err := w.ctx.FindObjectsInit(w.session, template); err != nil {
+		return nil, fmt.Errorf("failed to list PKCS#11 token entries: %w", err)
+	}
+	defer w.ctx.FindObjectsFinal(w.session)
+
+	handles, _, err := w.ctx.FindObjects(w.session, 1<<16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PKCS#11 token entries: %w", err)
+	}
+
+	labels := make([]string, 0, len(handles))
+	for _, handle := range handles {
+		attrs, err := w.ctx.GetAttributeValue(w.session, handle, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PKCS#11 token entry label: %w", err)
+		}
+		labels = append(labels, string(attrs[0].Value))
+	}
+	return labels, nil
+}
+
+func (w *PKCS11Wallet) Remove(label string) error {
+	handle, ok, err := w.findObject(label)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	if err := w.ctx.DestroyObject(w.session, handle); err != nil {
+		return fmt.Errorf("failed to remove identity [%s] from HSM: %w", label, err)
+	}
+	return nil
+}
+
+func (w *PKCS11Wallet) Exists(label string) bool {
+	_, ok, err := w.findObject(label)
+	return err == nil && ok
+}
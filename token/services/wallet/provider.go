@@ -0,0 +1,54 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/driver"
+)
+
+// IdentityProvider decorates a driver.IdentityProvider so that
+// LookupIdentifier and GetIdentityInfo first consult a configured Chain of
+// wallets, falling back to the wrapped provider when the chain has no entry
+// for the requested label. This lets production deployments plug in a
+// filesystem- or HSM-backed wallet without touching driver code.
+type IdentityProvider struct {
+	driver.IdentityProvider
+	Chain Chain
+}
+
+// NewIdentityProvider returns an IdentityProvider that consults chain before
+// falling back to local.
+func NewIdentityProvider(local driver.IdentityProvider, chain Chain) *IdentityProvider {
+	return &IdentityProvider{IdentityProvider: local, Chain: chain}
+}
+
+// LookupIdentifier resolves v against the wallet chain first; if no wallet
+// holds a matching label it defers to the wrapped provider.
+func (p *IdentityProvider) LookupIdentifier(usage driver.IdentityUsage, v interface{}) (view.Identity, string) {
+	if label, ok := v.(string); ok && p.Chain.Exists(label) {
+		if id, err := p.Chain.Get(label); err == nil {
+			return id.ID, label
+		}
+	}
+	return p.IdentityProvider.LookupIdentifier(usage, v)
+}
+
+// GetIdentityInfo resolves id against the wallet chain first; if no wallet
+// holds it, it defers to the wrapped provider.
+func (p *IdentityProvider) GetIdentityInfo(usage driver.IdentityUsage, id string) *driver.IdentityInfo {
+	if p.Chain.Exists(id) {
+		entry, err := p.Chain.Get(id)
+		if err == nil {
+			return &driver.IdentityInfo{
+				ID:          id,
+				GetIdentity: func() (view.Identity, error) { return entry.ID, nil },
+			}
+		}
+	}
+	return p.IdentityProvider.GetIdentityInfo(usage, id)
+}
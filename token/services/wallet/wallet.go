@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package wallet provides pluggable identity storage backends, modeled on
+// the Gateway SDK's wallet SPI. It decouples where identities live (memory,
+// filesystem, an HSM) from driver.IdentityProvider, which only needs to know
+// how to look one up by label.
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+)
+
+// IdentityData carries the raw material needed to reconstruct an Identity:
+// its type (e.g. "X509", "idemix"), owning MSP, and opaque credential bytes.
+type IdentityData struct {
+	Type        string
+	MSPID       string
+	Credentials []byte
+}
+
+// Identity is the long-term identity stored under a label, together with
+// the data needed to rebuild it.
+type Identity struct {
+	ID   view.Identity
+	Data IdentityData
+}
+
+// Wallet stores identities under human-readable labels. Implementations need
+// not be concurrency-safe beyond what their backend already guarantees.
+type Wallet interface {
+	// Put stores id under label, overwriting any existing entry.
+	Put(label string, id Identity) error
+	// Get returns the identity stored under label.
+	Get(label string) (Identity, error)
+	// List returns every label currently stored.
+	List() ([]string, error)
+	// Remove deletes the identity stored under label, if any.
+	Remove(label string) error
+	// Exists reports whether label is present.
+	Exists(label string) bool
+}
+
+// ErrNotFound is returned by Get when label has no associated identity.
+type ErrNotFound struct {
+	Label string
+}
+
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no identity found for label [%s]", e.Label)
+}
+
+// Chain queries a sequence of wallets in order, returning the first hit.
+// It lets an IdentityProvider be configured with, say, a filesystem wallet
+// for operator-provisioned identities and a PKCS#11 wallet for HSM-backed
+// ones, without hard-coding which backend holds which identity.
+type Chain []Wallet
+
+// Get returns the first identity found for label across the chain.
+func (c Chain) Get(label string) (Identity, error) {
+	for _, w := range c {
+		if w.Exists(label) {
+			return w.Get(label)
+		}
+	}
+	return Identity{}, &ErrNotFound{Label: label}
+}
+
+// Exists reports whether any wallet in the chain holds label.
+func (c Chain) Exists(label string) bool {
+	for _, w := range c {
+		if w.Exists(label) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/fabric-smart-client/platform/view/view"
+	"github.com/hyperledger-labs/fabric-token-sdk/token/services/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRoundTrip(t *testing.T, w wallet.Wallet) {
+	id := wallet.Identity{
+		ID:   view.Identity("alice-pub-key"),
+		Data: wallet.IdentityData{Type: "X509", MSPID: "Org1MSP", Credentials: []byte("cert")},
+	}
+
+	assert.False(t, w.Exists("alice"))
+	_, err := w.Get("alice")
+	assert.Error(t, err)
+
+	assert.NoError(t, w.Put("alice", id))
+	assert.True(t, w.Exists("alice"))
+
+	got, err := w.Get("alice")
+	assert.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	labels, err := w.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, labels)
+
+	assert.NoError(t, w.Remove("alice"))
+	assert.False(t, w.Exists("alice"))
+}
+
+func TestInMemoryWallet(t *testing.T) {
+	testRoundTrip(t, wallet.NewInMemoryWallet())
+}
+
+func TestFileSystemWallet(t *testing.T) {
+	w, err := wallet.NewFileSystemWallet(filepath.Join(t.TempDir(), "wallet"))
+	assert.NoError(t, err)
+	testRoundTrip(t, w)
+}
+
+func TestFileSystemWalletRejectsPathTraversal(t *testing.T) {
+	w, err := wallet.NewFileSystemWallet(filepath.Join(t.TempDir(), "wallet"))
+	assert.NoError(t, err)
+
+	label := "../../../../etc/cron.d/x"
+	assert.False(t, w.Exists(label))
+	assert.Error(t, w.Put(label, wallet.Identity{ID: view.Identity("mallory")}))
+	_, err = w.Get(label)
+	assert.Error(t, err)
+	assert.Error(t, w.Remove(label))
+}
+
+func TestChain(t *testing.T) {
+	first := wallet.NewInMemoryWallet()
+	second := wallet.NewInMemoryWallet()
+	assert.NoError(t, second.Put("bob", wallet.Identity{ID: view.Identity("bob-pub-key")}))
+
+	chain := wallet.Chain{first, second}
+	assert.False(t, chain.Exists("alice"))
+	assert.True(t, chain.Exists("bob"))
+
+	id, err := chain.Get("bob")
+	assert.NoError(t, err)
+	assert.Equal(t, view.Identity("bob-pub-key"), id.ID)
+
+	_, err = chain.Get("alice")
+	assert.Error(t, err)
+}